@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-redis/redis"
+	"golang.org/x/sync/singleflight"
+)
+
+// cacheKeyPrefix versions the canonical cache key so a future change to
+// CacheEntry's shape can coexist with, or cleanly replace, entries written
+// by an older version of this service.
+const cacheKeyPrefix = "wx:v1:"
+
+// freshTTL is how long an entry is served with no revalidation at all.
+// staleTTL is how much longer after that it's still served (immediately,
+// stale) while a refresh happens in the background; past staleTTL an entry
+// is treated as a miss.
+const (
+	freshTTL = 10 * time.Minute
+	staleTTL = time.Hour
+)
+
+// cacheFreshness classifies how an entry of a given age should be served.
+type cacheFreshness int
+
+const (
+	cacheFresh cacheFreshness = iota
+	cacheStale
+	cacheMiss
+)
+
+// freshnessOf classifies age against freshTTL/staleTTL.
+func freshnessOf(age time.Duration) cacheFreshness {
+	switch {
+	case age < freshTTL:
+		return cacheFresh
+	case age < staleTTL:
+		return cacheStale
+	default:
+		return cacheMiss
+	}
+}
+
+// CacheEntry is what's stored under a canonical cache key.
+type CacheEntry struct {
+	Weather   Weather       `json:"weather"`
+	FetchedAt time.Time     `json:"fetchedAt"`
+	TTL       time.Duration `json:"ttl"`
+	Provider  string        `json:"provider"`
+}
+
+// refreshGroup collapses concurrent cache misses/stale-refreshes for the
+// same normalized location into a single upstream call.
+var refreshGroup singleflight.Group
+
+// normalizeLocation collapses whitespace and casing so "London",
+// "london ", and "LONDON" all resolve to the same cache entry.
+func normalizeLocation(location string) string {
+	return strings.Join(strings.Fields(strings.ToLower(location)), " ")
+}
+
+func canonicalKey(location string) string {
+	return cacheKeyPrefix + normalizeLocation(location)
+}
+
+// loadCacheEntry looks up location's canonical entry, following a secondary
+// alias pointer (e.g. a station name) if location isn't itself canonical.
+func loadCacheEntry(redisClient *redis.Client, location string) (*CacheEntry, error) {
+	key := canonicalKey(location)
+	raw, err := redisClient.Get(key).Result()
+	if err == redis.Nil {
+		if pointer, perr := redisClient.Get(location).Result(); perr == nil && strings.HasPrefix(pointer, cacheKeyPrefix) {
+			raw, err = redisClient.Get(pointer).Result()
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entry CacheEntry
+	if err := json.Unmarshal([]byte(raw), &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// storeCacheEntry writes weather under location's canonical key, and a
+// secondary pointer key for every other alias (resolved address, stations,
+// ...) it came back with, so a lookup by any alias finds the same entry
+// instead of a duplicated copy of it.
+func storeCacheEntry(redisClient *redis.Client, location string, weather *Weather, providerName string) error {
+	key := canonicalKey(location)
+	entry := CacheEntry{
+		Weather:   *weather,
+		FetchedAt: time.Now(),
+		TTL:       staleTTL,
+		Provider:  providerName,
+	}
+
+	encoded, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := redisClient.Set(key, encoded, staleTTL).Err(); err != nil {
+		return err
+	}
+
+	for _, alias := range weather.Locations {
+		if alias == "" || canonicalKey(alias) == key {
+			continue
+		}
+		if err := redisClient.Set(alias, key, staleTTL).Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getOrRefresh serves location's current weather from cache when it's
+// fresh, triggers an async refresh and serves the stale value when it's
+// merely stale, and otherwise fetches synchronously - collapsing concurrent
+// callers for the same location into one upstream call either way.
+func getOrRefresh(ctx context.Context, redisClient *redis.Client, location string) (*Weather, error) {
+	entry, err := loadCacheEntry(redisClient, location)
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	if entry != nil {
+		switch freshnessOf(time.Since(entry.FetchedAt)) {
+		case cacheFresh:
+			atomic.AddInt64(&cacheHits, 1)
+			return &entry.Weather, nil
+		case cacheStale:
+			atomic.AddInt64(&cacheHits, 1)
+			go refreshInBackground(redisClient, location)
+			return &entry.Weather, nil
+		}
+	}
+
+	atomic.AddInt64(&cacheMisses, 1)
+	weather, err, _ := refreshGroup.Do(normalizeLocation(location), func() (interface{}, error) {
+		return processRequest(ctx, redisClient, location)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return weather.(*Weather), nil
+}
+
+func refreshInBackground(redisClient *redis.Client, location string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err, _ := refreshGroup.Do(normalizeLocation(location), func() (interface{}, error) {
+		return processRequest(ctx, redisClient, location)
+	})
+	if err != nil {
+		log.Print(err)
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFreshnessOfBoundaries(t *testing.T) {
+	cases := []struct {
+		name string
+		age  time.Duration
+		want cacheFreshness
+	}{
+		{"well within freshTTL", 0, cacheFresh},
+		{"just under freshTTL", freshTTL - time.Second, cacheFresh},
+		{"exactly freshTTL is no longer fresh", freshTTL, cacheStale},
+		{"just under staleTTL", staleTTL - time.Second, cacheStale},
+		{"exactly staleTTL is a miss", staleTTL, cacheMiss},
+		{"well past staleTTL", staleTTL + time.Hour, cacheMiss},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := freshnessOf(c.age); got != c.want {
+				t.Errorf("freshnessOf(%v) = %v, want %v", c.age, got, c.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeLocation(t *testing.T) {
+	cases := map[string]string{
+		"London":      "london",
+		" LONDON  ":   "london",
+		"New  York  ": "new york",
+	}
+	for in, want := range cases {
+		if got := normalizeLocation(in); got != want {
+			t.Errorf("normalizeLocation(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+// DayForecast is a single day's outlook as returned by the Visual Crossing
+// "days" array.
+type DayForecast struct {
+	Date       string         `json:"datetime"`
+	TempMax    float32        `json:"tempmax"`
+	TempMin    float32        `json:"tempmin"`
+	Temp       float32        `json:"temp"`
+	Conditions string         `json:"conditions"`
+	Humidity   float32        `json:"humidity"`
+	WindSpeed  float32        `json:"windspeed"`
+	Pressure   float32        `json:"pressure"`
+	Hours      []HourForecast `json:"hours,omitempty"`
+}
+
+// HourForecast is a single hour's outlook nested under a DayForecast.
+type HourForecast struct {
+	Time       string  `json:"datetime"`
+	Temp       float32 `json:"temp"`
+	Conditions string  `json:"conditions"`
+	Humidity   float32 `json:"humidity"`
+	WindSpeed  float32 `json:"windspeed"`
+	Pressure   float32 `json:"pressure"`
+}
+
+// WeatherAlert is an active weather alert for a location.
+type WeatherAlert struct {
+	Event       string `json:"event"`
+	Headline    string `json:"headline"`
+	Severity    string `json:"severity"`
+	Onset       string `json:"onset"`
+	Ends        string `json:"ends"`
+	Description string `json:"description"`
+}
+
+const defaultForecastDays = 7
+
+// forecastCacheTTL mirrors the TTL used for current conditions; forecasts
+// change on the same cadence as the upstream provider refreshes them.
+const forecastCacheTTL = time.Hour
+
+// forecastKey namespaces a day's cached forecast under its location and date
+// so it can be looked up directly without re-fetching the whole response.
+func forecastKey(location, date string) string {
+	return fmt.Sprintf("forecast:%s:%s", location, date)
+}
+
+// alertsDate is the pseudo-date forecastKey uses to cache a location's
+// active alerts alongside its per-day entries, since alerts aren't
+// associated with a single day.
+const alertsDate = "alerts"
+
+// cacheForecast stores each day of the forecast under its own namespaced key,
+// plus the alerts under alertsDate, so later /hourly and /alerts lookups
+// don't need to re-fetch the full payload.
+func cacheForecast(redisClient *redis.Client, location string, forecast *Forecast) error {
+	for _, day := range forecast.Days {
+		encoded, err := json.Marshal(day)
+		if err != nil {
+			return err
+		}
+		if err := redisClient.Set(forecastKey(location, day.Date), encoded, forecastCacheTTL).Err(); err != nil {
+			return err
+		}
+	}
+
+	encoded, err := json.Marshal(forecast.Alerts)
+	if err != nil {
+		return err
+	}
+	return redisClient.Set(forecastKey(location, alertsDate), encoded, forecastCacheTTL).Err()
+}
+
+// cachedForecastDays attempts to assemble days consecutive days of forecast,
+// starting today, entirely from cached per-day entries. It succeeds only if
+// every day in the window is already cached.
+func cachedForecastDays(redisClient *redis.Client, location string, days int) ([]DayForecast, bool) {
+	out := make([]DayForecast, 0, days)
+	now := time.Now()
+	for i := 0; i < days; i++ {
+		date := now.AddDate(0, 0, i).Format("2006-01-02")
+		cached, err := redisClient.Get(forecastKey(location, date)).Result()
+		if err != nil {
+			return nil, false
+		}
+		var day DayForecast
+		if err := json.Unmarshal([]byte(cached), &day); err != nil {
+			return nil, false
+		}
+		out = append(out, day)
+	}
+	return out, true
+}
+
+func forecastHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	redisClient := ctx.Value("redisClient").(*redis.Client)
+
+	queryParams := r.URL.Query()
+	location, _, err := locationFromRequest(ctx, redisClient, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	days := defaultForecastDays
+	if raw := queryParams.Get("days"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("days query parameter must be a positive integer"))
+			return
+		}
+		days = parsed
+	}
+
+	daysOut, ok := cachedForecastDays(redisClient, location, days)
+	if !ok {
+		forecast, err := provider.Forecast(ctx, newLocation(location), ForecastOptions{Days: days})
+		if err != nil {
+			log.Print(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		if err := cacheForecast(redisClient, location, forecast); err != nil {
+			log.Print(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		daysOut = forecast.Days
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(daysOut)
+}
+
+func hourlyHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	redisClient := ctx.Value("redisClient").(*redis.Client)
+
+	queryParams := r.URL.Query()
+	date := queryParams.Get("date")
+	if len(date) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("missing date query parameter"))
+		return
+	}
+
+	location, _, err := locationFromRequest(ctx, redisClient, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var day DayForecast
+	cached, err := redisClient.Get(forecastKey(location, date)).Result()
+	if err == nil {
+		if err := json.Unmarshal([]byte(cached), &day); err != nil {
+			log.Print(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+	} else if err == redis.Nil {
+		forecast, err := provider.Forecast(ctx, newLocation(location), ForecastOptions{Days: defaultForecastDays})
+		if err != nil {
+			log.Print(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		if err := cacheForecast(redisClient, location, forecast); err != nil {
+			log.Print(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		found := false
+		for _, d := range forecast.Days {
+			if d.Date == date {
+				day = d
+				found = true
+				break
+			}
+		}
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("no forecast available for that date"))
+			return
+		}
+	} else {
+		log.Print(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(day.Hours)
+}
+
+func alertsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	redisClient := ctx.Value("redisClient").(*redis.Client)
+
+	location, _, err := locationFromRequest(ctx, redisClient, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var alerts []WeatherAlert
+	cached, err := redisClient.Get(forecastKey(location, alertsDate)).Result()
+	if err == nil {
+		if err := json.Unmarshal([]byte(cached), &alerts); err != nil {
+			log.Print(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+	} else if err == redis.Nil {
+		forecast, err := provider.Forecast(ctx, newLocation(location), ForecastOptions{Days: defaultForecastDays})
+		if err != nil {
+			log.Print(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		if err := cacheForecast(redisClient, location, forecast); err != nil {
+			log.Print(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		alerts = forecast.Alerts
+	} else {
+		log.Print(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(alerts)
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+// geocodeCacheTTL is long because a city's coordinates essentially never
+// change; this is what makes repeated lookups for the same city free after
+// the first one.
+const geocodeCacheTTL = 30 * 24 * time.Hour
+
+// LocationQuery mirrors the OneOfLocation pattern used by gRPC weather
+// services: a caller supplies exactly one of City, Zip, or a Lat/Lon pair.
+type LocationQuery struct {
+	City string
+	Zip  string
+	Lat  *float64
+	Lon  *float64
+}
+
+// cacheKey identifies q for the geocode cache. Lat/Lon queries have no
+// cache key since they need no geocoding.
+func (q LocationQuery) cacheKey() string {
+	switch {
+	case q.City != "":
+		return "geo:city:" + normalizeLocation(q.City)
+	case q.Zip != "":
+		return "geo:zip:" + normalizeLocation(q.Zip)
+	default:
+		return ""
+	}
+}
+
+// parseLocationQuery reads city/zip/lat/lon from query params, requiring
+// exactly one of the three to be present.
+func parseLocationQuery(values url.Values) (LocationQuery, error) {
+	var q LocationQuery
+	set := 0
+
+	if city := values.Get("city"); city != "" {
+		q.City = city
+		set++
+	}
+	if zip := values.Get("zip"); zip != "" {
+		q.Zip = zip
+		set++
+	}
+	if latRaw, lonRaw := values.Get("lat"), values.Get("lon"); latRaw != "" || lonRaw != "" {
+		lat, err := strconv.ParseFloat(latRaw, 64)
+		if err != nil {
+			return LocationQuery{}, fmt.Errorf("invalid lat query parameter")
+		}
+		lon, err := strconv.ParseFloat(lonRaw, 64)
+		if err != nil {
+			return LocationQuery{}, fmt.Errorf("invalid lon query parameter")
+		}
+		q.Lat, q.Lon = &lat, &lon
+		set++
+	}
+
+	switch set {
+	case 0:
+		return LocationQuery{}, fmt.Errorf("must provide one of city, zip, or lat and lon query parameters")
+	case 1:
+		return q, nil
+	default:
+		return LocationQuery{}, fmt.Errorf("must provide only one of city, zip, or lat/lon query parameters")
+	}
+}
+
+// Geocoder resolves a LocationQuery into a canonical Location.
+type Geocoder interface {
+	Geocode(ctx context.Context, q LocationQuery) (*Location, error)
+}
+
+// geocoder is the Geocoder this service is currently backed by, selected
+// once at startup via geocoderFromEnv.
+var geocoder Geocoder
+
+// geocoderFromEnv selects a Geocoder based on the GEOCODER environment
+// variable, defaulting to OpenWeatherMap's geocoding API.
+func geocoderFromEnv() (Geocoder, error) {
+	switch os.Getenv("GEOCODER") {
+	case "", "openweathermap":
+		return newOpenWeatherMapGeocoder(os.Getenv("OPENWEATHERMAP_API_KEY")), nil
+	case "nominatim":
+		return newNominatimGeocoder(), nil
+	default:
+		return nil, fmt.Errorf("unknown GEOCODER %q", os.Getenv("GEOCODER"))
+	}
+}
+
+// resolveLocation resolves q to a Location, via the long-lived geocode
+// cache when q is a city/zip, or directly when q is already a lat/lon pair.
+func resolveLocation(ctx context.Context, redisClient *redis.Client, q LocationQuery) (*Location, error) {
+	if q.Lat != nil && q.Lon != nil {
+		return &Location{Lat: q.Lat, Lon: q.Lon}, nil
+	}
+
+	key := q.cacheKey()
+	if cached, err := redisClient.Get(key).Result(); err == nil {
+		var loc Location
+		if err := json.Unmarshal([]byte(cached), &loc); err == nil {
+			return &loc, nil
+		}
+	}
+
+	loc, err := geocoder.Geocode(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	if encoded, err := json.Marshal(loc); err == nil {
+		if err := redisClient.Set(key, encoded, geocodeCacheTTL).Err(); err != nil {
+			log.Print(err)
+		}
+	}
+	return loc, nil
+}
+
+// locationFromRequest resolves a request's location down to the canonical
+// "lat,lon" string the rest of the service keys everything on (the legacy
+// `location` free-text param if present, otherwise city/zip/lat,lon
+// resolved through the geocoding layer), alongside a human-readable display
+// name for rendering and popularity tracking. displayName falls back to the
+// canonical string itself when the geocoder didn't resolve one (e.g. a bare
+// lat/lon request).
+func locationFromRequest(ctx context.Context, redisClient *redis.Client, r *http.Request) (location, displayName string, err error) {
+	if raw := r.URL.Query().Get("location"); raw != "" {
+		return raw, raw, nil
+	}
+
+	q, err := parseLocationQuery(r.URL.Query())
+	if err != nil {
+		return "", "", err
+	}
+
+	loc, err := resolveLocation(ctx, redisClient, q)
+	if err != nil {
+		return "", "", err
+	}
+
+	lat, lon, ok := loc.Coordinates()
+	if !ok {
+		return "", "", fmt.Errorf("geocoder did not return coordinates")
+	}
+	location = fmt.Sprintf("%f,%f", lat, lon)
+	displayName = loc.DisplayName
+	if displayName == "" {
+		displayName = location
+	}
+	return location, displayName, nil
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestLocationQueryCacheKey(t *testing.T) {
+	cases := []struct {
+		name string
+		q    LocationQuery
+		want string
+	}{
+		{"city", LocationQuery{City: "  LONDON "}, "geo:city:london"},
+		{"zip", LocationQuery{Zip: "94107"}, "geo:zip:94107"},
+		{"lat/lon has no cache key", LocationQuery{Lat: floatPtr(1), Lon: floatPtr(2)}, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.q.cacheKey(); got != c.want {
+				t.Errorf("cacheKey() = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func floatPtr(f float64) *float64 { return &f }
+
+func TestParseLocationQueryRequiresExactlyOne(t *testing.T) {
+	if _, err := parseLocationQuery(url.Values{}); err == nil {
+		t.Error("expected an error when no location parameter is given")
+	}
+
+	multi := url.Values{"city": {"London"}, "zip": {"94107"}}
+	if _, err := parseLocationQuery(multi); err == nil {
+		t.Error("expected an error when more than one location parameter is given")
+	}
+
+	q, err := parseLocationQuery(url.Values{"city": {"London"}})
+	if err != nil {
+		t.Fatalf("parseLocationQuery: %v", err)
+	}
+	if q.City != "London" {
+		t.Errorf("City = %q, want %q", q.City, "London")
+	}
+}
+
+func TestParseLocationQueryLatLon(t *testing.T) {
+	q, err := parseLocationQuery(url.Values{"lat": {"51.5"}, "lon": {"-0.12"}})
+	if err != nil {
+		t.Fatalf("parseLocationQuery: %v", err)
+	}
+	if q.Lat == nil || *q.Lat != 51.5 || q.Lon == nil || *q.Lon != -0.12 {
+		t.Errorf("got lat=%v lon=%v, want lat=51.5 lon=-0.12", q.Lat, q.Lon)
+	}
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-redis/redis"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	weatherpb "github.com/khaleelsyed/Weather-API/proto"
+)
+
+// grpcServer implements weatherpb.WeatherServiceServer on top of the same
+// provider, cache, and geocoding layers the HTTP handlers use.
+type grpcServer struct {
+	weatherpb.UnimplementedWeatherServiceServer
+	redisClient *redis.Client
+}
+
+func newGRPCServer(redisClient *redis.Client) *grpcServer {
+	return &grpcServer{redisClient: redisClient}
+}
+
+// locationFromPB resolves a LocationRequest down to the same canonical
+// "lat,lon" string the HTTP API's city/zip/lat,lon query parameters key the
+// cache on, alongside a human-readable display name for rendering and
+// popularity tracking, just like the HTTP API's locationFromRequest.
+func (s *grpcServer) locationFromPB(ctx context.Context, req *weatherpb.LocationRequest) (location, displayName string, err error) {
+	var q LocationQuery
+	switch loc := req.GetLocation().(type) {
+	case *weatherpb.LocationRequest_City:
+		q.City = loc.City
+	case *weatherpb.LocationRequest_Zip:
+		q.Zip = loc.Zip
+	case *weatherpb.LocationRequest_Coordinates:
+		lat, lon := loc.Coordinates.GetLat(), loc.Coordinates.GetLon()
+		q.Lat, q.Lon = &lat, &lon
+	default:
+		return "", "", status.Error(codes.InvalidArgument, "must provide one of city, zip, or coordinates")
+	}
+
+	resolved, err := resolveLocation(ctx, s.redisClient, q)
+	if err != nil {
+		return "", "", mapProviderError(err)
+	}
+
+	lat, lon, ok := resolved.Coordinates()
+	if !ok {
+		return "", "", status.Error(codes.NotFound, "geocoder did not return coordinates")
+	}
+	location = fmt.Sprintf("%f,%f", lat, lon)
+	displayName = resolved.DisplayName
+	if displayName == "" {
+		displayName = location
+	}
+	return location, displayName, nil
+}
+
+func (s *grpcServer) GetCurrent(ctx context.Context, req *weatherpb.LocationRequest) (*weatherpb.CurrentWeather, error) {
+	location, displayName, err := s.locationFromPB(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	trackPopularity(s.redisClient, location, displayName)
+
+	weather, err := getOrRefresh(ctx, s.redisClient, location)
+	if err != nil {
+		return nil, mapProviderError(err)
+	}
+
+	return &weatherpb.CurrentWeather{
+		Location:   displayName,
+		Temp:       weather.Temp,
+		Conditions: weather.Conditions,
+	}, nil
+}
+
+func (s *grpcServer) GetForecast(ctx context.Context, req *weatherpb.GetForecastRequest) (*weatherpb.GetForecastResponse, error) {
+	location, _, err := s.locationFromPB(ctx, req.GetLocation())
+	if err != nil {
+		return nil, err
+	}
+
+	days := int(req.GetDays())
+	if days <= 0 {
+		days = defaultForecastDays
+	}
+
+	forecast, err := provider.Forecast(ctx, newLocation(location), ForecastOptions{Days: days})
+	if err != nil {
+		return nil, mapProviderError(err)
+	}
+	if err := cacheForecast(s.redisClient, location, forecast); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "caching forecast: %v", err)
+	}
+
+	pbDays := make([]*weatherpb.Day, 0, len(forecast.Days))
+	for _, d := range forecast.Days {
+		pbDays = append(pbDays, &weatherpb.Day{
+			Date:       d.Date,
+			TempMax:    d.TempMax,
+			TempMin:    d.TempMin,
+			Temp:       d.Temp,
+			Conditions: d.Conditions,
+			Humidity:   d.Humidity,
+			WindSpeed:  d.WindSpeed,
+			Pressure:   d.Pressure,
+		})
+	}
+	return &weatherpb.GetForecastResponse{Days: pbDays}, nil
+}
+
+// StreamUpdates pushes a WeatherUpdate to the client every time the
+// background prefetch loop (or any other path through processRequest)
+// refreshes the subscribed location's cache entry, until the client
+// disconnects.
+func (s *grpcServer) StreamUpdates(req *weatherpb.StreamUpdatesRequest, stream weatherpb.WeatherService_StreamUpdatesServer) error {
+	ctx := stream.Context()
+	location, displayName, err := s.locationFromPB(ctx, req.GetLocation())
+	if err != nil {
+		return err
+	}
+
+	ch, unsubscribe := updates.subscribe(location)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case weather := <-ch:
+			update := &weatherpb.WeatherUpdate{
+				Location:   displayName,
+				Temp:       weather.Temp,
+				Conditions: weather.Conditions,
+			}
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// mapProviderError translates a provider/geocoder error into the gRPC
+// status code a client can act on, instead of collapsing every failure
+// into a raw error string. ErrNotFound means the upstream affirmatively
+// reported no match for the location; ErrAPIResponse covers everything
+// else about a malformed or unexpected response, which isn't the client's
+// fault and shouldn't be reported as if the city doesn't exist.
+func mapProviderError(err error) error {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, ErrAPIResponse):
+		return status.Errorf(codes.Unavailable, "%v", err)
+	case errors.Is(err, ErrAPIConnect):
+		return status.Errorf(codes.Unavailable, "%v", err)
+	default:
+		return status.Errorf(codes.Unavailable, "%v", err)
+	}
+}
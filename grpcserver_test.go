@@ -0,0 +1,34 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMapProviderError(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"not found is NotFound", fmt.Errorf("nominatim: %w: no match for %q", ErrNotFound, "Nowhereville"), codes.NotFound},
+		{"response parse failure is Unavailable, not NotFound", fmt.Errorf("visualcrossing: %w: unexpected status 503", ErrAPIResponse), codes.Unavailable},
+		{"connect failure is Unavailable", fmt.Errorf("openweathermap: %w: timeout", ErrAPIConnect), codes.Unavailable},
+		{"unrecognized error is Unavailable", errors.New("boom"), codes.Unavailable},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := status.FromError(mapProviderError(c.err))
+			if !ok {
+				t.Fatalf("mapProviderError(%v) did not return a gRPC status", c.err)
+			}
+			if got.Code() != c.want {
+				t.Errorf("mapProviderError(%v) code = %v, want %v", c.err, got.Code(), c.want)
+			}
+		})
+	}
+}
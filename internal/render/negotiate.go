@@ -0,0 +1,35 @@
+package render
+
+import "strings"
+
+// Negotiate picks a Format from the request's explicit ?format= query
+// param, falling back to the Accept header, and finally to sniffing the
+// User-Agent for curl/wget so a bare `curl` gets the human-friendly ANSI
+// report instead of raw JSON.
+func Negotiate(queryFormat, accept, userAgent string) Format {
+	switch strings.ToLower(queryFormat) {
+	case "json":
+		return FormatJSON
+	case "j1":
+		return FormatJ1
+	case "text":
+		return FormatText
+	case "ansi":
+		return FormatANSI
+	}
+
+	lowerAccept := strings.ToLower(accept)
+	switch {
+	case strings.Contains(lowerAccept, "application/json"):
+		return FormatJSON
+	case strings.Contains(lowerAccept, "text/plain"):
+		return FormatText
+	}
+
+	lowerUA := strings.ToLower(userAgent)
+	if strings.HasPrefix(lowerUA, "curl/") || strings.HasPrefix(lowerUA, "wget/") {
+		return FormatANSI
+	}
+
+	return FormatJSON
+}
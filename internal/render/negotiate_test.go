@@ -0,0 +1,26 @@
+package render
+
+import "testing"
+
+func TestNegotiatePrecedence(t *testing.T) {
+	cases := []struct {
+		name      string
+		query     string
+		accept    string
+		userAgent string
+		want      Format
+	}{
+		{"explicit query wins over everything", "text", "application/json", "curl/8.0", FormatText},
+		{"accept header used when no query", "", "application/json", "curl/8.0", FormatJSON},
+		{"curl user-agent used when no query or accept", "", "", "curl/8.0", FormatANSI},
+		{"wget user-agent also gets ansi", "", "", "Wget/1.21", FormatANSI},
+		{"defaults to json", "", "", "", FormatJSON},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Negotiate(c.query, c.accept, c.userAgent); got != c.want {
+				t.Errorf("Negotiate(%q, %q, %q) = %q, want %q", c.query, c.accept, c.userAgent, got, c.want)
+			}
+		})
+	}
+}
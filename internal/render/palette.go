@@ -0,0 +1,49 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ANSI escape codes used by the console report. Kept unexported since
+// renderANSI is the only consumer.
+const (
+	ansiReset  = "\033[0m"
+	ansiBold   = "\033[1m"
+	ansiYellow = "\033[33m"
+	ansiBlue   = "\033[34m"
+	ansiCyan   = "\033[36m"
+	ansiGray   = "\033[37m"
+	ansiPurple = "\033[35m"
+	ansiGreen  = "\033[32m"
+)
+
+// colorForCondition maps a free-text condition string (as reported by any
+// provider) to the ANSI color that best represents it. Matching is by
+// substring since providers don't share a condition code vocabulary.
+func colorForCondition(conditions string) string {
+	lower := strings.ToLower(conditions)
+	switch {
+	case strings.Contains(lower, "thunder"), strings.Contains(lower, "storm"):
+		return ansiPurple
+	case strings.Contains(lower, "snow"), strings.Contains(lower, "sleet"), strings.Contains(lower, "ice"):
+		return ansiCyan
+	case strings.Contains(lower, "rain"), strings.Contains(lower, "drizzle"), strings.Contains(lower, "shower"):
+		return ansiBlue
+	case strings.Contains(lower, "cloud"), strings.Contains(lower, "overcast"), strings.Contains(lower, "fog"), strings.Contains(lower, "mist"):
+		return ansiGray
+	case strings.Contains(lower, "clear"), strings.Contains(lower, "sun"):
+		return ansiYellow
+	default:
+		return ansiGreen
+	}
+}
+
+func renderANSI(w io.Writer, weather Weather) error {
+	color := colorForCondition(weather.Conditions)
+	report := ansiBold + weather.Location + ansiReset + "\n" +
+		color + fmt.Sprintf("%.1f°C", weather.Temp) + ansiReset + "  " + weather.Conditions + ansiReset + "\n"
+	_, err := w.Write([]byte(report))
+	return err
+}
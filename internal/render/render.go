@@ -0,0 +1,92 @@
+// Package render formats a current-conditions reading for the various
+// content types weatherHandler can serve: full JSON, a wttr.in-style
+// one-liner, an ANSI console report, and a compact "j1" JSON summary.
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Format selects which representation Render produces.
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatText Format = "text"
+	FormatANSI Format = "ansi"
+	FormatJ1   Format = "j1"
+)
+
+// Weather is the subset of a weather reading render needs; it is decoupled
+// from the main package's Weather type so this package has no import-cycle
+// risk and can be reused by anything that has a location/temp/conditions.
+// Days and Alerts are carried through so FormatJSON, the default format,
+// serves the full reading rather than silently dropping forecast data.
+type Weather struct {
+	Location   string  `json:"location"`
+	Temp       float32 `json:"temp"`
+	Conditions string  `json:"conditions"`
+	Days       []Day   `json:"days,omitempty"`
+	Alerts     []Alert `json:"alerts,omitempty"`
+}
+
+// Day mirrors the main package's DayForecast.
+type Day struct {
+	Date       string  `json:"datetime"`
+	TempMax    float32 `json:"tempmax"`
+	TempMin    float32 `json:"tempmin"`
+	Temp       float32 `json:"temp"`
+	Conditions string  `json:"conditions"`
+	Humidity   float32 `json:"humidity"`
+	WindSpeed  float32 `json:"windspeed"`
+	Pressure   float32 `json:"pressure"`
+}
+
+// Alert mirrors the main package's WeatherAlert.
+type Alert struct {
+	Event       string `json:"event"`
+	Headline    string `json:"headline"`
+	Severity    string `json:"severity"`
+	Onset       string `json:"onset"`
+	Ends        string `json:"ends"`
+	Description string `json:"description"`
+}
+
+// j1Summary is the compact shape used by FormatJ1, named after wttr.in's
+// own "?format=j1" convention. It intentionally stays current-conditions
+// only; j1 is meant to be terse.
+type j1Summary struct {
+	Loc  string  `json:"loc"`
+	TmpC float32 `json:"tmpC"`
+	Wx   string  `json:"wx"`
+}
+
+// Render writes weather to w in the given format.
+func Render(w io.Writer, format Format, weather Weather) error {
+	switch format {
+	case FormatJSON:
+		return json.NewEncoder(w).Encode(weather)
+	case FormatJ1:
+		return json.NewEncoder(w).Encode(j1Summary{Loc: weather.Location, TmpC: weather.Temp, Wx: weather.Conditions})
+	case FormatText:
+		_, err := fmt.Fprintf(w, "%s: %.1f°C, %s\n", weather.Location, weather.Temp, weather.Conditions)
+		return err
+	case FormatANSI:
+		return renderANSI(w, weather)
+	default:
+		return fmt.Errorf("render: unknown format %q", format)
+	}
+}
+
+// ContentType is the HTTP Content-Type that should accompany a response in
+// the given format.
+func ContentType(format Format) string {
+	switch format {
+	case FormatJSON, FormatJ1:
+		return "application/json"
+	default:
+		return "text/plain; charset=utf-8"
+	}
+}
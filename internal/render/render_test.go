@@ -0,0 +1,47 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestRenderJSONIncludesDaysAndAlerts(t *testing.T) {
+	var buf bytes.Buffer
+	weather := Weather{
+		Location:   "London",
+		Temp:       12.3,
+		Conditions: "Clear",
+		Days:       []Day{{Date: "2026-07-28", Temp: 12.3}},
+		Alerts:     []Alert{{Event: "Heat Advisory"}},
+	}
+	if err := Render(&buf, FormatJSON, weather); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	var got Weather
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if len(got.Days) != 1 || got.Days[0].Date != "2026-07-28" {
+		t.Errorf("Days = %+v, want one day dated 2026-07-28", got.Days)
+	}
+	if len(got.Alerts) != 1 || got.Alerts[0].Event != "Heat Advisory" {
+		t.Errorf("Alerts = %+v, want one Heat Advisory alert", got.Alerts)
+	}
+}
+
+func TestRenderJ1StaysCompact(t *testing.T) {
+	var buf bytes.Buffer
+	weather := Weather{
+		Location: "London",
+		Temp:     12.3,
+		Days:     []Day{{Date: "2026-07-28"}},
+	}
+	if err := Render(&buf, FormatJ1, weather); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	if bytes.Contains(buf.Bytes(), []byte("datetime")) {
+		t.Errorf("j1 output unexpectedly carries forecast data: %s", buf.String())
+	}
+}
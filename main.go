@@ -2,141 +2,179 @@ package main
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
-	"fmt"
-	"io"
 	"log"
+	"net"
 	"net/http"
 	"os"
-	"strconv"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/go-redis/redis"
 	"github.com/gorilla/mux"
+	"github.com/khaleelsyed/Weather-API/internal/render"
+	weatherpb "github.com/khaleelsyed/Weather-API/proto"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
 )
 
 type Weather struct {
-	Locations []string
-	Temp      float32
+	Locations  []string
+	Temp       float32
+	Conditions string
+	Days       []DayForecast
+	Alerts     []WeatherAlert
 }
 
-type APIResponse struct {
-	Address           string `json:"address"`
-	ResolvedAddress   string `json:"resolvedAddress"`
-	CurrentConditions struct {
-		Temp     float32  `json:"temp"`
-		Stations []string `json:"stations"`
-	} `json:"currentConditions"`
-}
-
-func (r APIResponse) Handle(redisClient *redis.Client) (*Weather, error) {
-	locations := make([]string, 2+len(r.CurrentConditions.Stations))
-	locations[0] = r.Address
-	locations[1] = r.ResolvedAddress
-	if len(r.CurrentConditions.Stations) > 0 {
-		for i := range r.CurrentConditions.Stations {
-			locations[2+i] = r.CurrentConditions.Stations[i]
-		}
-	}
-	weather := Weather{
-		Locations: locations,
-		Temp:      r.CurrentConditions.Temp,
-	}
-
-	for _, key := range locations {
-		err := redisClient.Set(key, convertFloat32ToString(weather.Temp), time.Hour).Err()
-		if err != nil {
-			return nil, err
-		}
-	}
-	return &weather, nil
-}
-
-var ErrAPIConnect error = errors.New("failed to connect to the Visual Crossing API")
-var ErrAPIResponse error = errors.New("something happened with the response from the Visual Crossing API")
-
-func callWeatherAPI(location string) (*APIResponse, error) {
-	response, err := http.Get(fmt.Sprintf("https://weather.visualcrossing.com/VisualCrossingWebServices/rest/services/timeline/%s?unitGroup=uk&key=%s&contentType=json", location, os.Getenv("VISUAL_CROSSING_API_KEY")))
-	if err != nil {
-		return nil, ErrAPIConnect
-	}
-	defer response.Body.Close()
-
-	var apiResponse APIResponse
-	body, err := io.ReadAll(response.Body)
-	if err != nil {
-		log.Print(err)
-		return nil, ErrAPIResponse
-	}
-
-	err = json.Unmarshal(body, &apiResponse)
-	if err != nil {
-		log.Print(err)
-		return nil, ErrAPIResponse
-	}
-
-	return &apiResponse, nil
-}
+// provider is the WeatherProvider this service is currently backed by,
+// selected once at startup via providerFromEnv.
+var provider WeatherProvider
 
 func weatherHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	redisClient := ctx.Value("redisClient").(*redis.Client)
 
 	queryParams := r.URL.Query()
-	location := queryParams.Get("location")
-	if len(location) == 0 {
+	location, displayName, err := locationFromRequest(ctx, redisClient, r)
+	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
-		w.Write([]byte("missing location query parameter"))
+		w.Write([]byte(err.Error()))
 		return
 	}
 
-	val, err := redisClient.Get(location).Result()
-	if err == redis.Nil {
-		apiResponse, err := callWeatherAPI(location)
-		if err != nil {
-			log.Print(err)
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write([]byte(err.Error()))
-			return
-		}
-		weather, err := apiResponse.Handle(redisClient)
-		if err != nil {
-			log.Print(err)
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write([]byte(err.Error()))
-			return
-		}
-		val = convertFloat32ToString(weather.Temp)
+	trackPopularity(redisClient, location, displayName)
 
-	} else if err != nil {
+	weather, err := getOrRefresh(ctx, redisClient, location)
+	if err != nil {
 		log.Print(err)
 		w.WriteHeader(http.StatusInternalServerError)
 		w.Write([]byte(err.Error()))
 		return
 	}
 
+	format := render.Negotiate(queryParams.Get("format"), r.Header.Get("Accept"), r.Header.Get("User-Agent"))
+	w.Header().Set("Content-Type", render.ContentType(format))
 	w.WriteHeader(http.StatusOK)
-	w.Write([]byte(val))
+	render.Render(w, format, render.Weather{
+		Location:   displayName,
+		Temp:       weather.Temp,
+		Conditions: weather.Conditions,
+		Days:       renderDays(weather.Days),
+		Alerts:     renderAlerts(weather.Alerts),
+	})
+}
+
+// renderDays converts days to the render package's decoupled Day type.
+func renderDays(days []DayForecast) []render.Day {
+	if len(days) == 0 {
+		return nil
+	}
+	out := make([]render.Day, len(days))
+	for i, d := range days {
+		out[i] = render.Day{
+			Date:       d.Date,
+			TempMax:    d.TempMax,
+			TempMin:    d.TempMin,
+			Temp:       d.Temp,
+			Conditions: d.Conditions,
+			Humidity:   d.Humidity,
+			WindSpeed:  d.WindSpeed,
+			Pressure:   d.Pressure,
+		}
+	}
+	return out
+}
+
+// renderAlerts converts alerts to the render package's decoupled Alert type.
+func renderAlerts(alerts []WeatherAlert) []render.Alert {
+	if len(alerts) == 0 {
+		return nil
+	}
+	out := make([]render.Alert, len(alerts))
+	for i, a := range alerts {
+		out[i] = render.Alert{
+			Event:       a.Event,
+			Headline:    a.Headline,
+			Severity:    a.Severity,
+			Onset:       a.Onset,
+			Ends:        a.Ends,
+			Description: a.Description,
+		}
+	}
+	return out
 }
 
 func main() {
+	var err error
+	provider, err = providerFromEnv()
+	if err != nil {
+		log.Panic(err)
+	}
+	geocoder, err = geocoderFromEnv()
+	if err != nil {
+		log.Panic(err)
+	}
+
 	opt, err := redis.ParseURL(os.Getenv("REDIS_CONNECTION_STRING"))
 	if err != nil {
 		log.Panic(err)
 	}
 	redisClient := redis.NewClient(opt)
 
+	withRedis := func(handler http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), "redisClient", redisClient)
+			handler(w, r.WithContext(ctx))
+		}
+	}
+
+	scheduler := startPrefetchScheduler(redisClient)
+	defer scheduler.Stop()
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
-		ctx := context.WithValue(r.Context(), "redisClient", redisClient)
-		r = r.WithContext(ctx)
-		weatherHandler(w, r)
-	})
+	r.HandleFunc("/", withRedis(weatherHandler))
+	r.HandleFunc("/forecast", withRedis(forecastHandler))
+	r.HandleFunc("/hourly", withRedis(hourlyHandler))
+	r.HandleFunc("/alerts", withRedis(alertsHandler))
+	r.HandleFunc("/admin/popular", withRedis(adminPopularHandler))
+	r.HandleFunc("/admin/prefetch", withRedis(adminPrefetchHandler))
+	r.HandleFunc("/admin/metrics", adminMetricsHandler)
 
-	log.Fatal(http.ListenAndServe(":8080", r))
-}
+	httpServer := &http.Server{Addr: ":8080", Handler: r}
+
+	grpcListener, err := net.Listen("tcp", ":9090")
+	if err != nil {
+		log.Panic(err)
+	}
+	grpcSrv := grpc.NewServer()
+	weatherpb.RegisterWeatherServiceServer(grpcSrv, newGRPCServer(redisClient))
 
-func convertFloat32ToString(f float32) string {
-	return strconv.FormatFloat(float64(f), 'f', -1, 32)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			return err
+		}
+		return nil
+	})
+	g.Go(func() error {
+		if err := grpcSrv.Serve(grpcListener); err != nil {
+			return err
+		}
+		return nil
+	})
+	g.Go(func() error {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		httpServer.Shutdown(shutdownCtx)
+		grpcSrv.GracefulStop()
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		log.Fatal(err)
+	}
 }
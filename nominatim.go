@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// nominatimGeocoder resolves locations via OpenStreetMap's Nominatim
+// /search endpoint. It needs no API key, unlike the OWM geocoder.
+type nominatimGeocoder struct{}
+
+func newNominatimGeocoder() *nominatimGeocoder {
+	return &nominatimGeocoder{}
+}
+
+type nominatimResult struct {
+	DisplayName string `json:"display_name"`
+	Lat         string `json:"lat"`
+	Lon         string `json:"lon"`
+}
+
+func (g *nominatimGeocoder) Geocode(ctx context.Context, q LocationQuery) (*Location, error) {
+	query := q.City
+	if query == "" {
+		query = q.Zip
+	}
+
+	reqURL := fmt.Sprintf("https://nominatim.openstreetmap.org/search?%s", url.Values{
+		"q":      {query},
+		"format": {"json"},
+		"limit":  {"1"},
+	}.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("nominatim: %w: %v", ErrAPIConnect, err)
+	}
+	// Nominatim's usage policy requires a descriptive User-Agent.
+	req.Header.Set("User-Agent", "Weather-API (github.com/khaleelsyed/Weather-API)")
+
+	response, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("nominatim: %w: %v", ErrAPIConnect, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("nominatim: %w: no match for %q", ErrNotFound, query)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("nominatim: %w: unexpected status %s", ErrAPIResponse, response.Status)
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		log.Print(err)
+		return nil, fmt.Errorf("nominatim: %w: %v", ErrAPIResponse, err)
+	}
+
+	var results []nominatimResult
+	if err := json.Unmarshal(body, &results); err != nil {
+		log.Print(err)
+		return nil, fmt.Errorf("nominatim: %w: %v", ErrAPIResponse, err)
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("nominatim: %w: no match for %q", ErrNotFound, query)
+	}
+
+	lat, err := strconv.ParseFloat(results[0].Lat, 64)
+	if err != nil {
+		return nil, fmt.Errorf("nominatim: %w: %v", ErrAPIResponse, err)
+	}
+	lon, err := strconv.ParseFloat(results[0].Lon, 64)
+	if err != nil {
+		return nil, fmt.Errorf("nominatim: %w: %v", ErrAPIResponse, err)
+	}
+
+	return &Location{Lat: &lat, Lon: &lon, DisplayName: results[0].DisplayName}, nil
+}
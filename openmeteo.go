@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// openMeteoProvider backs the service with Open-Meteo, which needs no API
+// key but only accepts latitude/longitude rather than free-text addresses.
+// A Location without coordinates must have a "lat,lon" Query so one can be
+// parsed out of it; the geocoding layer is what normally supplies this.
+type openMeteoProvider struct{}
+
+func newOpenMeteoProvider() *openMeteoProvider {
+	return &openMeteoProvider{}
+}
+
+func (p *openMeteoProvider) Name() string {
+	return "open-meteo"
+}
+
+// resolveLatLon prefers loc's resolved coordinates and otherwise parses a
+// "lat,lon" Query, since Open-Meteo has no concept of a free-text address.
+func resolveLatLon(loc Location) (lat, lon string, err error) {
+	if lat, lon, ok := loc.Coordinates(); ok {
+		return strconv.FormatFloat(lat, 'f', -1, 64), strconv.FormatFloat(lon, 'f', -1, 64), nil
+	}
+	if lat, lon, ok := tryParseLatLon(loc.Query); ok {
+		return strconv.FormatFloat(lat, 'f', -1, 64), strconv.FormatFloat(lon, 'f', -1, 64), nil
+	}
+	return "", "", fmt.Errorf("open-meteo: %w: location must resolve to coordinates", ErrAPIResponse)
+}
+
+func (p *openMeteoProvider) get(ctx context.Context, query url.Values, out interface{}) error {
+	reqURL := fmt.Sprintf("https://api.open-meteo.com/v1/forecast?%s", query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("open-meteo: %w: %v", ErrAPIConnect, err)
+	}
+
+	response, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("open-meteo: %w: %v", ErrAPIConnect, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("open-meteo: %w: %s", ErrNotFound, reqURL)
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("open-meteo: %w: unexpected status %s", ErrAPIResponse, response.Status)
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		log.Print(err)
+		return fmt.Errorf("open-meteo: %w: %v", ErrAPIResponse, err)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		log.Print(err)
+		return fmt.Errorf("open-meteo: %w: %v", ErrAPIResponse, err)
+	}
+
+	return nil
+}
+
+type openMeteoResponse struct {
+	CurrentWeather struct {
+		Temperature float32 `json:"temperature"`
+		WindSpeed   float32 `json:"windspeed"`
+	} `json:"current_weather"`
+	Hourly struct {
+		Time        []string  `json:"time"`
+		Temperature []float32 `json:"temperature_2m"`
+		Humidity    []float32 `json:"relativehumidity_2m"`
+		Pressure    []float32 `json:"pressure_msl"`
+		WindSpeed   []float32 `json:"windspeed_10m"`
+	} `json:"hourly"`
+	Daily struct {
+		Time    []string  `json:"time"`
+		TempMax []float32 `json:"temperature_2m_max"`
+		TempMin []float32 `json:"temperature_2m_min"`
+	} `json:"daily"`
+}
+
+func (p *openMeteoProvider) Current(ctx context.Context, loc Location) (*Weather, error) {
+	lat, lon, err := resolveLatLon(loc)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp openMeteoResponse
+	if err := p.get(ctx, url.Values{
+		"latitude":        {lat},
+		"longitude":       {lon},
+		"current_weather": {"true"},
+	}, &resp); err != nil {
+		return nil, err
+	}
+
+	return &Weather{
+		Locations: []string{loc.Query},
+		Temp:      resp.CurrentWeather.Temperature,
+	}, nil
+}
+
+func (p *openMeteoProvider) Forecast(ctx context.Context, loc Location, opts ForecastOptions) (*Forecast, error) {
+	lat, lon, err := resolveLatLon(loc)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp openMeteoResponse
+	if err := p.get(ctx, url.Values{
+		"latitude":  {lat},
+		"longitude": {lon},
+		"hourly":    {"temperature_2m,relativehumidity_2m,pressure_msl,windspeed_10m"},
+		"daily":     {"temperature_2m_max,temperature_2m_min"},
+		"timezone":  {"auto"},
+	}, &resp); err != nil {
+		return nil, err
+	}
+
+	hoursByDate := make(map[string][]HourForecast)
+	for i, t := range resp.Hourly.Time {
+		date := strings.SplitN(t, "T", 2)[0]
+		hoursByDate[date] = append(hoursByDate[date], HourForecast{
+			Time:      t,
+			Temp:      resp.Hourly.Temperature[i],
+			Humidity:  resp.Hourly.Humidity[i],
+			Pressure:  resp.Hourly.Pressure[i],
+			WindSpeed: resp.Hourly.WindSpeed[i],
+		})
+	}
+
+	days := make([]DayForecast, 0, len(resp.Daily.Time))
+	for i, date := range resp.Daily.Time {
+		days = append(days, DayForecast{
+			Date:    date,
+			TempMax: resp.Daily.TempMax[i],
+			TempMin: resp.Daily.TempMin[i],
+			Hours:   hoursByDate[date],
+		})
+	}
+
+	if opts.Days > 0 && len(days) > opts.Days {
+		days = days[:opts.Days]
+	}
+
+	return &Forecast{Days: days}, nil
+}
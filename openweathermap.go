@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// openWeatherMapProvider backs the service with OpenWeatherMap's
+// /data/2.5/weather and /data/2.5/forecast endpoints. OWM reports
+// temperatures in Kelvin by default, so every reading is converted to
+// Celsius before it reaches the normalized Weather/Forecast types.
+type openWeatherMapProvider struct {
+	apiKey string
+}
+
+func newOpenWeatherMapProvider(apiKey string) *openWeatherMapProvider {
+	return &openWeatherMapProvider{apiKey: apiKey}
+}
+
+func (p *openWeatherMapProvider) Name() string {
+	return "openweathermap"
+}
+
+func kelvinToCelsius(k float32) float32 {
+	return k - 273.15
+}
+
+type owmWeatherEntry struct {
+	Main struct {
+		Temp     float32 `json:"temp"`
+		Humidity float32 `json:"humidity"`
+		Pressure float32 `json:"pressure"`
+	} `json:"main"`
+	Weather []struct {
+		Main string `json:"main"`
+	} `json:"weather"`
+	Wind struct {
+		Speed float32 `json:"speed"`
+	} `json:"wind"`
+}
+
+func (e owmWeatherEntry) conditions() string {
+	if len(e.Weather) == 0 {
+		return ""
+	}
+	return e.Weather[0].Main
+}
+
+type owmCurrentResponse struct {
+	owmWeatherEntry
+	Name string `json:"name"`
+}
+
+func (p *openWeatherMapProvider) get(ctx context.Context, path string, query url.Values, out interface{}) error {
+	query.Set("appid", p.apiKey)
+	reqURL := fmt.Sprintf("https://api.openweathermap.org/data/2.5/%s?%s", path, query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("openweathermap: %w: %v", ErrAPIConnect, err)
+	}
+
+	response, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("openweathermap: %w: %v", ErrAPIConnect, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("openweathermap: %w: %s", ErrNotFound, path)
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("openweathermap: %w: unexpected status %s", ErrAPIResponse, response.Status)
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		log.Print(err)
+		return fmt.Errorf("openweathermap: %w: %v", ErrAPIResponse, err)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		log.Print(err)
+		return fmt.Errorf("openweathermap: %w: %v", ErrAPIResponse, err)
+	}
+
+	return nil
+}
+
+// locationQuery builds the q=/lat=&lon= parameters OWM expects, preferring
+// resolved coordinates over the free-text query when both are available.
+func locationQuery(loc Location) url.Values {
+	if lat, lon, ok := loc.Coordinates(); ok {
+		return url.Values{
+			"lat": {strconv.FormatFloat(lat, 'f', -1, 64)},
+			"lon": {strconv.FormatFloat(lon, 'f', -1, 64)},
+		}
+	}
+	return url.Values{"q": {loc.Query}}
+}
+
+func (p *openWeatherMapProvider) Current(ctx context.Context, loc Location) (*Weather, error) {
+	var resp owmCurrentResponse
+	if err := p.get(ctx, "weather", locationQuery(loc), &resp); err != nil {
+		return nil, err
+	}
+
+	return &Weather{
+		Locations:  []string{loc.Query, resp.Name},
+		Temp:       kelvinToCelsius(resp.Main.Temp),
+		Conditions: resp.conditions(),
+	}, nil
+}
+
+type owmForecastResponse struct {
+	List []struct {
+		owmWeatherEntry
+		DateTime string `json:"dt_txt"`
+	} `json:"list"`
+}
+
+func (p *openWeatherMapProvider) Forecast(ctx context.Context, loc Location, opts ForecastOptions) (*Forecast, error) {
+	var resp owmForecastResponse
+	if err := p.get(ctx, "forecast", locationQuery(loc), &resp); err != nil {
+		return nil, err
+	}
+
+	byDate := make(map[string]*DayForecast)
+	var order []string
+	for _, entry := range resp.List {
+		date := strings.SplitN(entry.DateTime, " ", 2)[0]
+		day, ok := byDate[date]
+		if !ok {
+			day = &DayForecast{Date: date}
+			byDate[date] = day
+			order = append(order, date)
+		}
+		temp := kelvinToCelsius(entry.Main.Temp)
+		day.Hours = append(day.Hours, HourForecast{
+			Time:       entry.DateTime,
+			Temp:       temp,
+			Conditions: entry.conditions(),
+			Humidity:   entry.Main.Humidity,
+			WindSpeed:  entry.Wind.Speed,
+			Pressure:   entry.Main.Pressure,
+		})
+	}
+
+	days := make([]DayForecast, 0, len(order))
+	for _, date := range order {
+		day := byDate[date]
+		day.Temp, day.TempMax, day.TempMin = dailySummary(day.Hours)
+		day.Conditions = day.Hours[0].Conditions
+		day.Humidity = day.Hours[0].Humidity
+		day.WindSpeed = day.Hours[0].WindSpeed
+		day.Pressure = day.Hours[0].Pressure
+		days = append(days, *day)
+	}
+
+	if opts.Days > 0 && len(days) > opts.Days {
+		days = days[:opts.Days]
+	}
+
+	return &Forecast{Days: days}, nil
+}
+
+// dailySummary reduces a day's hourly readings to an average, max, and min
+// temperature, the way Visual Crossing's "days" array already does upstream.
+func dailySummary(hours []HourForecast) (avg, max, min float32) {
+	if len(hours) == 0 {
+		return 0, 0, 0
+	}
+	max, min = hours[0].Temp, hours[0].Temp
+	var sum float32
+	for _, h := range hours {
+		sum += h.Temp
+		if h.Temp > max {
+			max = h.Temp
+		}
+		if h.Temp < min {
+			min = h.Temp
+		}
+	}
+	return sum / float32(len(hours)), max, min
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+)
+
+// openWeatherMapGeocoder resolves locations via OWM's /geo/1.0/direct (city
+// name) and /geo/1.0/zip (postal code) endpoints.
+type openWeatherMapGeocoder struct {
+	apiKey string
+}
+
+func newOpenWeatherMapGeocoder(apiKey string) *openWeatherMapGeocoder {
+	return &openWeatherMapGeocoder{apiKey: apiKey}
+}
+
+type owmGeoResult struct {
+	Name    string  `json:"name"`
+	Lat     float64 `json:"lat"`
+	Lon     float64 `json:"lon"`
+	Country string  `json:"country"`
+}
+
+type owmZipResult struct {
+	Name    string  `json:"name"`
+	Lat     float64 `json:"lat"`
+	Lon     float64 `json:"lon"`
+	Country string  `json:"country"`
+}
+
+func (g *openWeatherMapGeocoder) get(ctx context.Context, path string, query url.Values, out interface{}) error {
+	query.Set("appid", g.apiKey)
+	reqURL := fmt.Sprintf("https://api.openweathermap.org/geo/1.0/%s?%s", path, query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("openweathermap geocoder: %w: %v", ErrAPIConnect, err)
+	}
+
+	response, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("openweathermap geocoder: %w: %v", ErrAPIConnect, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("openweathermap geocoder: %w: %s", ErrNotFound, path)
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("openweathermap geocoder: %w: unexpected status %s", ErrAPIResponse, response.Status)
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		log.Print(err)
+		return fmt.Errorf("openweathermap geocoder: %w: %v", ErrAPIResponse, err)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		log.Print(err)
+		return fmt.Errorf("openweathermap geocoder: %w: %v", ErrAPIResponse, err)
+	}
+
+	return nil
+}
+
+func (g *openWeatherMapGeocoder) Geocode(ctx context.Context, q LocationQuery) (*Location, error) {
+	if q.Zip != "" {
+		var result owmZipResult
+		if err := g.get(ctx, "zip", url.Values{"zip": {q.Zip}}, &result); err != nil {
+			return nil, err
+		}
+		return &Location{Lat: &result.Lat, Lon: &result.Lon, DisplayName: result.Name, Country: result.Country}, nil
+	}
+
+	var results []owmGeoResult
+	if err := g.get(ctx, "direct", url.Values{"q": {q.City}, "limit": {"1"}}, &results); err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("openweathermap geocoder: %w: no match for %q", ErrNotFound, q.City)
+	}
+
+	result := results[0]
+	return &Location{Lat: &result.Lat, Lon: &result.Lon, DisplayName: result.Name, Country: result.Country}, nil
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/robfig/cron/v3"
+)
+
+// popularityKey is the Redis sorted set tracking how often each location has
+// been requested, so the prefetch scheduler knows what's worth warming.
+const popularityKey = "popular"
+
+// defaultPrefetchTopN is how many of the most popular locations get
+// refreshed on each scheduler tick.
+const defaultPrefetchTopN = 20
+
+// prefetchSchedule re-fetches popular locations often enough that they
+// refresh before the hour-long cache TTL lapses.
+const prefetchSchedule = "@every 10m"
+
+var (
+	cacheHits     int64
+	cacheMisses   int64
+	prefetchRuns  int64
+	prefetchSaved int64
+)
+
+// popularNamesKey maps each location's canonical key to the display name it
+// resolved to, so /admin/popular can show a human-readable name instead of
+// a raw lat/lon string for geocoded lookups.
+const popularNamesKey = "popular:names"
+
+// trackPopularity bumps location's score in the popularity sorted set so the
+// prefetch scheduler can find the most-requested locations later, and
+// remembers displayName so /admin/popular can show it instead of location's
+// raw lat/lon string.
+func trackPopularity(redisClient *redis.Client, location, displayName string) {
+	if err := redisClient.ZIncrBy(popularityKey, 1, location).Err(); err != nil {
+		log.Print(err)
+	}
+	if displayName != "" && displayName != location {
+		if err := redisClient.HSet(popularNamesKey, location, displayName).Err(); err != nil {
+			log.Print(err)
+		}
+	}
+}
+
+// processRequest performs the same cache-fill work weatherHandler does on a
+// cache miss, but without an HTTP round trip, so the prefetch scheduler and
+// the admin force-warm endpoint can reuse it directly.
+func processRequest(ctx context.Context, redisClient *redis.Client, location string) (*Weather, error) {
+	weather, err := provider.Current(ctx, newLocation(location))
+	if err != nil {
+		return nil, err
+	}
+	if err := storeCacheEntry(redisClient, location, weather, provider.Name()); err != nil {
+		return nil, err
+	}
+	updates.publish(location, weather)
+	return weather, nil
+}
+
+// startPrefetchScheduler starts a background cron job that keeps the
+// top-N popular locations warm in cache so user requests for them avoid a
+// cache-miss round trip to the upstream provider.
+func startPrefetchScheduler(redisClient *redis.Client) *cron.Cron {
+	c := cron.New()
+	if _, err := c.AddFunc(prefetchSchedule, func() {
+		prefetchPopular(redisClient, defaultPrefetchTopN)
+	}); err != nil {
+		log.Panic(err)
+	}
+	c.Start()
+	return c
+}
+
+func prefetchPopular(redisClient *redis.Client, topN int) {
+	locations, err := redisClient.ZRevRange(popularityKey, 0, int64(topN-1)).Result()
+	if err != nil {
+		log.Print(err)
+		return
+	}
+
+	for _, location := range locations {
+		atomic.AddInt64(&prefetchRuns, 1)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		_, err := processRequest(ctx, redisClient, location)
+		cancel()
+		if err != nil {
+			log.Print(err)
+			continue
+		}
+		atomic.AddInt64(&prefetchSaved, 1)
+	}
+}
+
+// popularEntry is a single row of the /admin/popular listing.
+type popularEntry struct {
+	Location    string  `json:"location"`
+	DisplayName string  `json:"displayName,omitempty"`
+	Score       float64 `json:"score"`
+}
+
+func adminPopularHandler(w http.ResponseWriter, r *http.Request) {
+	redisClient := r.Context().Value("redisClient").(*redis.Client)
+
+	entries, err := redisClient.ZRevRangeWithScores(popularityKey, 0, 49).Result()
+	if err != nil {
+		log.Print(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	out := make([]popularEntry, 0, len(entries))
+	for _, entry := range entries {
+		location, _ := entry.Member.(string)
+		displayName, _ := redisClient.HGet(popularNamesKey, location).Result()
+		out = append(out, popularEntry{Location: location, DisplayName: displayName, Score: entry.Score})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(out)
+}
+
+func adminPrefetchHandler(w http.ResponseWriter, r *http.Request) {
+	redisClient := r.Context().Value("redisClient").(*redis.Client)
+
+	location := r.URL.Query().Get("location")
+	if len(location) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("missing location query parameter"))
+		return
+	}
+
+	atomic.AddInt64(&prefetchRuns, 1)
+	weather, err := processRequest(r.Context(), redisClient, location)
+	if err != nil {
+		log.Print(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	atomic.AddInt64(&prefetchSaved, 1)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(weather)
+}
+
+func adminMetricsHandler(w http.ResponseWriter, r *http.Request) {
+	hits := atomic.LoadInt64(&cacheHits)
+	misses := atomic.LoadInt64(&cacheMisses)
+	hitRatio := 0.0
+	if total := hits + misses; total > 0 {
+		hitRatio = float64(hits) / float64(total)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, `{"cacheHits":%d,"cacheMisses":%d,"hitRatio":%.4f,"prefetchRuns":%d,"prefetchSaved":%d}`,
+		hits, misses, hitRatio, atomic.LoadInt64(&prefetchRuns), atomic.LoadInt64(&prefetchSaved))
+}
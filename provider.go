@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Location identifies where a weather lookup is for: either free text a
+// caller passed in (an address, zip, or "lat,lon" pair) or, once it's been
+// through the geocoding layer, a resolved coordinate pair with a canonical
+// display name. Providers prefer Coordinates when present.
+type Location struct {
+	Query       string
+	Lat         *float64
+	Lon         *float64
+	DisplayName string
+	Country     string
+}
+
+// newLocation builds a Location from a raw query string, recognizing the
+// "lat,lon" shape produced by the geocoding layer (or passed directly by a
+// caller) so providers can use coordinates instead of free text.
+func newLocation(query string) Location {
+	if lat, lon, ok := tryParseLatLon(query); ok {
+		return Location{Query: query, Lat: &lat, Lon: &lon}
+	}
+	return Location{Query: query}
+}
+
+// Coordinates returns loc's latitude/longitude if it has one.
+func (loc Location) Coordinates() (lat, lon float64, ok bool) {
+	if loc.Lat != nil && loc.Lon != nil {
+		return *loc.Lat, *loc.Lon, true
+	}
+	return 0, 0, false
+}
+
+// tryParseLatLon reports whether query is a "lat,lon" pair and, if so,
+// parses it.
+func tryParseLatLon(query string) (lat, lon float64, ok bool) {
+	parts := strings.SplitN(query, ",", 2)
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	lat, latErr := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	lon, lonErr := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if latErr != nil || lonErr != nil {
+		return 0, 0, false
+	}
+	return lat, lon, true
+}
+
+// ForecastOptions controls how much forecast data a provider returns.
+type ForecastOptions struct {
+	Days int
+}
+
+// Forecast is the normalized multi-day/hourly/alerts payload returned by
+// Forecast, independent of which upstream provider produced it.
+type Forecast struct {
+	Days   []DayForecast
+	Alerts []WeatherAlert
+}
+
+// WeatherProvider is implemented by each upstream weather API this service
+// can be backed by. Current and Forecast both return the normalized Weather
+// and Forecast types so handlers never need to know which provider answered.
+type WeatherProvider interface {
+	Current(ctx context.Context, loc Location) (*Weather, error)
+	Forecast(ctx context.Context, loc Location, opts ForecastOptions) (*Forecast, error)
+	// Name identifies the provider for cache entries and diagnostics.
+	Name() string
+}
+
+// providerFromEnv selects a WeatherProvider based on the WEATHER_PROVIDER
+// environment variable, defaulting to Visual Crossing to match this
+// service's original behavior.
+func providerFromEnv() (WeatherProvider, error) {
+	switch os.Getenv("WEATHER_PROVIDER") {
+	case "", "visualcrossing":
+		return newVisualCrossingProvider(os.Getenv("VISUAL_CROSSING_API_KEY")), nil
+	case "openweathermap":
+		return newOpenWeatherMapProvider(os.Getenv("OPENWEATHERMAP_API_KEY")), nil
+	case "open-meteo":
+		return newOpenMeteoProvider(), nil
+	default:
+		return nil, fmt.Errorf("unknown WEATHER_PROVIDER %q", os.Getenv("WEATHER_PROVIDER"))
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import "testing"
+
+func TestNewLocationParsesLatLon(t *testing.T) {
+	loc := newLocation("51.5074,-0.1278")
+	lat, lon, ok := loc.Coordinates()
+	if !ok {
+		t.Fatalf("expected coordinates to be parsed from %q", loc.Query)
+	}
+	if lat != 51.5074 || lon != -0.1278 {
+		t.Errorf("got lat=%v lon=%v, want lat=51.5074 lon=-0.1278", lat, lon)
+	}
+}
+
+func TestNewLocationLeavesFreeTextAlone(t *testing.T) {
+	loc := newLocation("London")
+	if _, _, ok := loc.Coordinates(); ok {
+		t.Errorf("expected %q not to resolve to coordinates", loc.Query)
+	}
+}
+
+func TestTryParseLatLonRejectsNonNumeric(t *testing.T) {
+	if _, _, ok := tryParseLatLon("London,UK"); ok {
+		t.Error("expected \"London,UK\" not to parse as a lat,lon pair")
+	}
+}
+
+func TestKelvinToCelsius(t *testing.T) {
+	if got := kelvinToCelsius(273.15); got != 0 {
+		t.Errorf("kelvinToCelsius(273.15) = %v, want 0", got)
+	}
+}
+
+func TestOwmWeatherEntryConditions(t *testing.T) {
+	var empty owmWeatherEntry
+	if got := empty.conditions(); got != "" {
+		t.Errorf("conditions() on empty Weather slice = %q, want \"\"", got)
+	}
+
+	entry := owmWeatherEntry{}
+	entry.Weather = []struct {
+		Main string `json:"main"`
+	}{{Main: "Clouds"}}
+	if got := entry.conditions(); got != "Clouds" {
+		t.Errorf("conditions() = %q, want %q", got, "Clouds")
+	}
+}
+
+func TestDailySummary(t *testing.T) {
+	hours := []HourForecast{
+		{Temp: 10},
+		{Temp: 20},
+		{Temp: 0},
+	}
+	avg, max, min := dailySummary(hours)
+	if avg != 10 {
+		t.Errorf("avg = %v, want 10", avg)
+	}
+	if max != 20 {
+		t.Errorf("max = %v, want 20", max)
+	}
+	if min != 0 {
+		t.Errorf("min = %v, want 0", min)
+	}
+}
+
+func TestDailySummaryEmpty(t *testing.T) {
+	avg, max, min := dailySummary(nil)
+	if avg != 0 || max != 0 || min != 0 {
+		t.Errorf("dailySummary(nil) = (%v, %v, %v), want all zero", avg, max, min)
+	}
+}
+
+func TestResolveLatLonPrefersCoordinates(t *testing.T) {
+	lat, lon, err := resolveLatLon(newLocation("51.5074,-0.1278"))
+	if err != nil {
+		t.Fatalf("resolveLatLon: %v", err)
+	}
+	if lat != "51.5074" || lon != "-0.1278" {
+		t.Errorf("got lat=%q lon=%q, want lat=%q lon=%q", lat, lon, "51.5074", "-0.1278")
+	}
+}
+
+func TestResolveLatLonRejectsFreeText(t *testing.T) {
+	if _, _, err := resolveLatLon(newLocation("London")); err == nil {
+		t.Error("expected an error resolving free-text location to coordinates")
+	}
+}
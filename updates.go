@@ -0,0 +1,48 @@
+package main
+
+import "sync"
+
+// updateBroker fans out newly-refreshed Weather observations to any
+// gRPC StreamUpdates subscribers watching a given location.
+type updateBroker struct {
+	mu   sync.Mutex
+	subs map[string][]chan *Weather
+}
+
+var updates = &updateBroker{subs: make(map[string][]chan *Weather)}
+
+// subscribe registers a channel for location and returns it along with a
+// function that unregisters it. Callers must call the returned function
+// when done listening to avoid leaking the channel.
+func (b *updateBroker) subscribe(location string) (<-chan *Weather, func()) {
+	ch := make(chan *Weather, 1)
+
+	b.mu.Lock()
+	b.subs[location] = append(b.subs[location], ch)
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		subscribers := b.subs[location]
+		for i, c := range subscribers {
+			if c == ch {
+				b.subs[location] = append(subscribers[:i], subscribers[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// publish notifies every subscriber of location with weather. Subscribers
+// that aren't ready to receive are skipped rather than blocked on.
+func (b *updateBroker) publish(location string, weather *Weather) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, ch := range b.subs[location] {
+		select {
+		case ch <- weather:
+		default:
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+)
+
+// ErrAPIConnect, ErrAPIResponse, and ErrNotFound are the sentinel errors
+// every WeatherProvider and Geocoder wraps so callers can use errors.Is
+// regardless of which upstream produced the failure. ErrNotFound is
+// distinct from ErrAPIResponse because it means the upstream understood
+// the request and affirmatively reported no match, rather than returning a
+// malformed or unparseable body.
+var ErrAPIConnect error = errors.New("failed to connect to the weather API")
+var ErrAPIResponse error = errors.New("something happened with the response from the weather API")
+var ErrNotFound error = errors.New("no location matched the request")
+
+// APIResponse mirrors the shape returned by the Visual Crossing timeline API.
+type APIResponse struct {
+	Address           string `json:"address"`
+	ResolvedAddress   string `json:"resolvedAddress"`
+	CurrentConditions struct {
+		Temp       float32  `json:"temp"`
+		Conditions string   `json:"conditions"`
+		Stations   []string `json:"stations"`
+	} `json:"currentConditions"`
+	Days   []DayForecast  `json:"days"`
+	Alerts []WeatherAlert `json:"alerts"`
+}
+
+func (r APIResponse) toWeather() *Weather {
+	locations := make([]string, 2+len(r.CurrentConditions.Stations))
+	locations[0] = r.Address
+	locations[1] = r.ResolvedAddress
+	for i := range r.CurrentConditions.Stations {
+		locations[2+i] = r.CurrentConditions.Stations[i]
+	}
+	return &Weather{
+		Locations:  locations,
+		Temp:       r.CurrentConditions.Temp,
+		Conditions: r.CurrentConditions.Conditions,
+		Days:       r.Days,
+		Alerts:     r.Alerts,
+	}
+}
+
+// visualCrossingProvider backs the service with the Visual Crossing
+// timeline API, this service's original and default provider.
+type visualCrossingProvider struct {
+	apiKey string
+}
+
+func newVisualCrossingProvider(apiKey string) *visualCrossingProvider {
+	return &visualCrossingProvider{apiKey: apiKey}
+}
+
+func (p *visualCrossingProvider) Name() string {
+	return "visualcrossing"
+}
+
+func (p *visualCrossingProvider) fetch(ctx context.Context, loc Location) (*APIResponse, error) {
+	path := loc.Query
+	if lat, lon, ok := loc.Coordinates(); ok {
+		path = fmt.Sprintf("%f,%f", lat, lon)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://weather.visualcrossing.com/VisualCrossingWebServices/rest/services/timeline/%s?unitGroup=uk&key=%s&contentType=json", path, p.apiKey), nil)
+	if err != nil {
+		return nil, fmt.Errorf("visualcrossing: %w: %v", ErrAPIConnect, err)
+	}
+
+	response, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("visualcrossing: %w: %v", ErrAPIConnect, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("visualcrossing: %w: %s", ErrNotFound, path)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("visualcrossing: %w: unexpected status %s", ErrAPIResponse, response.Status)
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		log.Print(err)
+		return nil, fmt.Errorf("visualcrossing: %w: %v", ErrAPIResponse, err)
+	}
+
+	var apiResponse APIResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		log.Print(err)
+		return nil, fmt.Errorf("visualcrossing: %w: %v", ErrAPIResponse, err)
+	}
+
+	return &apiResponse, nil
+}
+
+func (p *visualCrossingProvider) Current(ctx context.Context, loc Location) (*Weather, error) {
+	apiResponse, err := p.fetch(ctx, loc)
+	if err != nil {
+		return nil, err
+	}
+	return apiResponse.toWeather(), nil
+}
+
+func (p *visualCrossingProvider) Forecast(ctx context.Context, loc Location, opts ForecastOptions) (*Forecast, error) {
+	apiResponse, err := p.fetch(ctx, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	days := apiResponse.Days
+	if opts.Days > 0 && len(days) > opts.Days {
+		days = days[:opts.Days]
+	}
+
+	return &Forecast{
+		Days:   days,
+		Alerts: apiResponse.Alerts,
+	}, nil
+}